@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"qr-menu-app/internal/api"
+	"qr-menu-app/internal/payments"
+	"qr-menu-app/internal/store"
+	"qr-menu-app/internal/ws"
+)
+
+func main() {
+	databaseURL := os.Getenv("DATABASE_URL")
+	if databaseURL == "" {
+		log.Fatal("DATABASE_URL environment variable is required")
+	}
+
+	db, err := store.NewPostgresStore(databaseURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("Database connected and migrated successfully")
+
+	hub := ws.NewHub()
+
+	gateway := payments.NewRazorpayGateway(
+		os.Getenv("RAZORPAY_KEY_ID"),
+		os.Getenv("RAZORPAY_KEY_SECRET"),
+		os.Getenv("RAZORPAY_WEBHOOK_SECRET"),
+	)
+
+	server := api.NewServer(db, hub, gateway)
+
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "5000"
+	}
+
+	fmt.Printf("Go server starting on port %s\n", port)
+	log.Fatal(http.ListenAndServe(":"+port, server.Router()))
+}
@@ -0,0 +1,223 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+
+	"qr-menu-app/internal/models"
+	"qr-menu-app/internal/observability"
+)
+
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 7 * 24 * time.Hour
+)
+
+// Token kind, stamped into claims.TokenType so an access token can't be
+// replayed where a refresh token is expected and vice versa.
+const (
+	tokenTypeAccess  = "access"
+	tokenTypeRefresh = "refresh"
+)
+
+type claims struct {
+	UserID       uint   `json:"userId"`
+	RestaurantID uint   `json:"restaurantId"`
+	Role         string `json:"role"`
+	TokenType    string `json:"typ"`
+	jwt.RegisteredClaims
+}
+
+type contextKey string
+
+const userContextKey contextKey = "authUser"
+
+// authenticatedUser is what handlers pull out of the request context once
+// requireAuth has run.
+type authenticatedUser struct {
+	UserID       uint
+	RestaurantID uint
+	Role         string
+}
+
+func userFromContext(ctx context.Context) (authenticatedUser, bool) {
+	u, ok := ctx.Value(userContextKey).(authenticatedUser)
+	return u, ok
+}
+
+func jwtSecret() []byte {
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		log.Fatal("JWT_SECRET environment variable is required")
+	}
+	return []byte(secret)
+}
+
+func signToken(u *models.User, ttl time.Duration, tokenType string) (string, error) {
+	now := time.Now()
+	c := claims{
+		UserID:       u.ID,
+		RestaurantID: u.RestaurantID,
+		Role:         u.Role,
+		TokenType:    tokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, c)
+	return token.SignedString(jwtSecret())
+}
+
+// parseToken validates the token's signature and expiry and checks that its
+// typ claim matches expectedType, so an access token can't be replayed as a
+// refresh token (or accepted by requireAuth) and vice versa.
+func parseToken(tokenString, expectedType string) (*claims, error) {
+	c := &claims{}
+	_, err := jwt.ParseWithClaims(tokenString, c, func(t *jwt.Token) (interface{}, error) {
+		return jwtSecret(), nil
+	}, jwt.WithValidMethods([]string{"HS256"}))
+	if err != nil {
+		return nil, err
+	}
+	if c.TokenType != expectedType {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	return c, nil
+}
+
+// requireAuth extracts and validates the bearer token, loads the claims into
+// the request context as an authenticatedUser, and rejects the request
+// unless the caller's role is one of allowedRoles. Tenant scoping is the
+// handler's job from there: routes keyed by restaurant_id in the URL use
+// restaurantPathMatches, and routes keyed by some other id (an order, a menu
+// item) rely on the store's tenant-scoped lookups.
+func (s *Server) requireAuth(allowedRoles ...string) func(http.HandlerFunc) http.HandlerFunc {
+	allowed := make(map[string]bool, len(allowedRoles))
+	for _, r := range allowedRoles {
+		allowed[r] = true
+	}
+
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			authHeader := r.Header.Get("Authorization")
+			if !strings.HasPrefix(authHeader, "Bearer ") {
+				http.Error(w, "Missing or invalid Authorization header", http.StatusUnauthorized)
+				return
+			}
+			tokenString := strings.TrimPrefix(authHeader, "Bearer ")
+
+			c, err := parseToken(tokenString, tokenTypeAccess)
+			if err != nil {
+				http.Error(w, "Invalid or expired token", http.StatusUnauthorized)
+				return
+			}
+
+			if !allowed[c.Role] {
+				http.Error(w, "Insufficient permissions", http.StatusForbidden)
+				return
+			}
+
+			observability.SetRestaurantID(r.Context(), c.RestaurantID)
+
+			ctx := context.WithValue(r.Context(), userContextKey, authenticatedUser{
+				UserID:       c.UserID,
+				RestaurantID: c.RestaurantID,
+				Role:         c.Role,
+			})
+			next(w, r.WithContext(ctx))
+		}
+	}
+}
+
+// restaurantPathMatches reports whether the {id} path var on a
+// /restaurant/{id}/... route matches the authenticated user's tenant.
+// Handlers for those routes call this right after parsing the path id.
+func restaurantPathMatches(ctx context.Context, restaurantID int) bool {
+	u, ok := userFromContext(ctx)
+	return ok && uint(restaurantID) == u.RestaurantID
+}
+
+func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Email    string `json:"email"`
+		Password string `json:"password"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	user, err := s.store.GetUserByEmail(r.Context(), req.Email)
+	if err != nil {
+		http.Error(w, "Invalid email or password", http.StatusUnauthorized)
+		return
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
+		http.Error(w, "Invalid email or password", http.StatusUnauthorized)
+		return
+	}
+
+	accessToken, err := signToken(user, accessTokenTTL, tokenTypeAccess)
+	if err != nil {
+		http.Error(w, "Failed to issue token", http.StatusInternalServerError)
+		return
+	}
+	refreshToken, err := signToken(user, refreshTokenTTL, tokenTypeRefresh)
+	if err != nil {
+		http.Error(w, "Failed to issue token", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"accessToken":  accessToken,
+		"refreshToken": refreshToken,
+		"user":         user,
+	})
+}
+
+func (s *Server) handleRefresh(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		RefreshToken string `json:"refreshToken"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	c, err := parseToken(req.RefreshToken, tokenTypeRefresh)
+	if err != nil {
+		http.Error(w, "Invalid or expired refresh token", http.StatusUnauthorized)
+		return
+	}
+
+	user, err := s.store.GetUserByID(r.Context(), c.UserID)
+	if err != nil {
+		http.Error(w, "User not found", http.StatusUnauthorized)
+		return
+	}
+
+	accessToken, err := signToken(user, accessTokenTTL, tokenTypeAccess)
+	if err != nil {
+		http.Error(w, "Failed to issue token", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"accessToken": accessToken})
+}
+
+func hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return string(hash), err
+}
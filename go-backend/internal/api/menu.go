@@ -0,0 +1,131 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"qr-menu-app/internal/models"
+	"qr-menu-app/internal/store"
+)
+
+func (s *Server) getMenuCategories(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	restaurantID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid restaurant ID", http.StatusBadRequest)
+		return
+	}
+
+	categories, err := s.store.ListMenuCategories(r.Context(), uint(restaurantID))
+	if err != nil {
+		http.Error(w, "Failed to fetch categories", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(categories)
+}
+
+func (s *Server) getMenuItems(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	restaurantID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid restaurant ID", http.StatusBadRequest)
+		return
+	}
+
+	var categoryID uint
+	if raw := r.URL.Query().Get("category"); raw != "" {
+		id, err := strconv.Atoi(raw)
+		if err != nil {
+			http.Error(w, "Invalid category ID", http.StatusBadRequest)
+			return
+		}
+		categoryID = uint(id)
+	}
+
+	items, err := s.store.ListMenuItems(r.Context(), uint(restaurantID), categoryID)
+	if err != nil {
+		http.Error(w, "Failed to fetch menu items", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(items)
+}
+
+func (s *Server) createMenuItem(w http.ResponseWriter, r *http.Request) {
+	var item models.MenuItem
+	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Never trust the body's restaurantId - a compromised/buggy client
+	// could otherwise write into another tenant's menu.
+	user, _ := userFromContext(r.Context())
+	item.RestaurantID = user.RestaurantID
+
+	if err := s.store.CreateMenuItem(r.Context(), &item); err != nil {
+		http.Error(w, "Failed to create menu item", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(item)
+}
+
+func (s *Server) updateMenuItem(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid menu item ID", http.StatusBadRequest)
+		return
+	}
+
+	var updates map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	user, _ := userFromContext(r.Context())
+	item, err := s.store.UpdateMenuItem(r.Context(), user.RestaurantID, uint(id), updates)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			http.Error(w, "Menu item not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to update menu item", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(item)
+}
+
+func (s *Server) deleteMenuItem(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid menu item ID", http.StatusBadRequest)
+		return
+	}
+
+	user, _ := userFromContext(r.Context())
+	if err := s.store.SetMenuItemAvailability(r.Context(), user.RestaurantID, uint(id), false); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			http.Error(w, "Menu item not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to delete menu item", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"message": "Menu item deleted successfully"})
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"qr-menu-app/internal/models"
+	"qr-menu-app/internal/store"
+	"qr-menu-app/internal/ws"
+)
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	t.Setenv("JWT_SECRET", "test-secret")
+	return NewServer(store.NewMemoryStore(), ws.NewHub(), nil)
+}
+
+func withAuth(r *http.Request, u authenticatedUser) *http.Request {
+	ctx := context.WithValue(r.Context(), userContextKey, u)
+	return r.WithContext(ctx)
+}
+
+func TestGetMenuItems(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	_ = s.store.CreateMenuItem(ctx, &models.MenuItem{RestaurantID: 1, CategoryID: 1, Name: "Paneer Tikka"})
+	_ = s.store.CreateMenuItem(ctx, &models.MenuItem{RestaurantID: 1, CategoryID: 2, Name: "Chicken Biryani"})
+	_ = s.store.CreateMenuItem(ctx, &models.MenuItem{RestaurantID: 2, CategoryID: 1, Name: "Other Tenant's Dish"})
+
+	tests := []struct {
+		name       string
+		url        string
+		wantCount  int
+		wantStatus int
+	}{
+		{name: "all items for restaurant", url: "/api/restaurant/1/menu", wantCount: 2, wantStatus: http.StatusOK},
+		{name: "filtered by category", url: "/api/restaurant/1/menu?category=1", wantCount: 1, wantStatus: http.StatusOK},
+		{name: "does not leak other tenant's items", url: "/api/restaurant/2/menu", wantCount: 1, wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": urlID(tt.url)})
+			rr := httptest.NewRecorder()
+
+			s.getMenuItems(rr, req)
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rr.Code, tt.wantStatus)
+			}
+
+			var items []models.MenuItem
+			if err := json.NewDecoder(rr.Body).Decode(&items); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if len(items) != tt.wantCount {
+				t.Errorf("len(items) = %d, want %d", len(items), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestCreateMenuItemScopesToAuthenticatedRestaurant(t *testing.T) {
+	s := newTestServer(t)
+
+	body := `{"name":"Masala Dosa","price":"120.00","categoryId":1,"restaurantId":3}`
+	req := httptest.NewRequest(http.MethodPost, "/api/menu-items", strings.NewReader(body))
+	req = withAuth(req, authenticatedUser{UserID: 1, RestaurantID: 7, Role: models.RoleManager})
+	rr := httptest.NewRecorder()
+
+	s.createMenuItem(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d, body=%s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+
+	var item models.MenuItem
+	if err := json.NewDecoder(rr.Body).Decode(&item); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if item.RestaurantID != 7 {
+		t.Errorf("RestaurantID = %d, want 7 (from token, not body)", item.RestaurantID)
+	}
+}
+
+func urlID(url string) string {
+	// crude helper: pulls the numeric restaurant id out of
+	// "/api/restaurant/{id}/menu[?...]" for test requests.
+	const prefix = "/api/restaurant/"
+	rest := url[len(prefix):]
+	for i, c := range rest {
+		if c < '0' || c > '9' {
+			return rest[:i]
+		}
+	}
+	return rest
+}
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"qr-menu-app/internal/observability"
+	"qr-menu-app/internal/store"
+	"qr-menu-app/internal/ws"
+)
+
+func (s *Server) getOrders(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	restaurantID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid restaurant ID", http.StatusBadRequest)
+		return
+	}
+	if !restaurantPathMatches(r.Context(), restaurantID) {
+		http.Error(w, "Restaurant mismatch", http.StatusForbidden)
+		return
+	}
+
+	status := r.URL.Query().Get("status")
+	orders, err := s.store.ListOrders(r.Context(), uint(restaurantID), status)
+	if err != nil {
+		http.Error(w, "Failed to fetch orders", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(orders)
+}
+
+// createOrder only accepts menuItemId/quantity/notes per line - the server
+// looks up each item's real price and the restaurant's charges so a
+// customer can't order a ₹500 biryani for ₹1 by editing the request body.
+func (s *Server) createOrder(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		RestaurantID uint    `json:"restaurantId"`
+		OrderType    string  `json:"orderType"`
+		TableNumber  *string `json:"tableNumber"`
+		CustomerName *string `json:"customerName"`
+		CustomerPhone *string `json:"customerPhone"`
+		Notes        *string `json:"notes"`
+		Items        []struct {
+			MenuItemID uint   `json:"menuItemId"`
+			Quantity   int    `json:"quantity"`
+			Notes      string `json:"notes"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	input := store.CreateOrderInput{
+		RestaurantID:   req.RestaurantID,
+		OrderType:      req.OrderType,
+		TableNumber:    req.TableNumber,
+		CustomerName:   req.CustomerName,
+		CustomerPhone:  req.CustomerPhone,
+		Notes:          req.Notes,
+		IdempotencyKey: r.Header.Get("Idempotency-Key"),
+	}
+	for _, item := range req.Items {
+		input.Items = append(input.Items, store.OrderLineRequest{
+			MenuItemID: item.MenuItemID,
+			Quantity:   item.Quantity,
+			Notes:      item.Notes,
+		})
+	}
+
+	order, err := s.store.CreateOrder(r.Context(), input)
+	if err != nil {
+		switch {
+		case errors.Is(err, store.ErrOrderEmpty), errors.Is(err, store.ErrInvalidQuantity):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case errors.Is(err, store.ErrItemUnavailable):
+			http.Error(w, err.Error(), http.StatusConflict)
+		case errors.Is(err, store.ErrNotFound):
+			http.Error(w, "Restaurant or menu item not found", http.StatusNotFound)
+		default:
+			http.Error(w, "Failed to create order", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	observability.RecordOrderCreated(order.RestaurantID, order.OrderType)
+	s.hub.Broadcast(ws.Event{Type: "newOrder", RestaurantID: order.RestaurantID, Payload: order})
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(order)
+}
+
+func (s *Server) updateOrderStatus(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid order ID", http.StatusBadRequest)
+		return
+	}
+
+	var request struct {
+		Status string `json:"status"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	user, _ := userFromContext(r.Context())
+	order, err := s.store.UpdateOrderStatus(r.Context(), user.RestaurantID, uint(id), request.Status)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			http.Error(w, "Order not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to update order status", http.StatusInternalServerError)
+		return
+	}
+
+	s.hub.Broadcast(ws.Event{Type: "orderStatusUpdate", RestaurantID: order.RestaurantID, Payload: order})
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(order)
+}
+
+func (s *Server) getOrderByNumber(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	orderNumber := vars["orderNumber"]
+
+	order, err := s.store.GetOrderByNumber(r.Context(), orderNumber)
+	if err != nil {
+		http.Error(w, "Order not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(order)
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"qr-menu-app/internal/models"
+	"qr-menu-app/internal/store"
+)
+
+func (s *Server) getRestaurants(w http.ResponseWriter, r *http.Request) {
+	restaurants, err := s.store.ListRestaurants(r.Context())
+	if err != nil {
+		http.Error(w, "Failed to fetch restaurants", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(restaurants)
+}
+
+func (s *Server) createRestaurant(w http.ResponseWriter, r *http.Request) {
+	var restaurant models.Restaurant
+	if err := json.NewDecoder(r.Body).Decode(&restaurant); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	restaurant.OrderModes = []string{"dine-in", "takeaway"}
+	restaurant.TrialStartDate = time.Now()
+
+	if err := s.store.CreateRestaurant(r.Context(), &restaurant); err != nil {
+		http.Error(w, "Failed to create restaurant", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(restaurant)
+}
+
+func (s *Server) getRestaurant(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid restaurant ID", http.StatusBadRequest)
+		return
+	}
+
+	restaurant, err := s.store.GetRestaurant(r.Context(), uint(id))
+	if err != nil {
+		http.Error(w, "Restaurant not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(restaurant)
+}
+
+func (s *Server) updateRestaurant(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid restaurant ID", http.StatusBadRequest)
+		return
+	}
+	if !restaurantPathMatches(r.Context(), id) {
+		http.Error(w, "Restaurant mismatch", http.StatusForbidden)
+		return
+	}
+
+	var updates map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	restaurant, err := s.store.UpdateRestaurant(r.Context(), uint(id), updates)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			http.Error(w, "Restaurant not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to update restaurant", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(restaurant)
+}
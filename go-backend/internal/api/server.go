@@ -0,0 +1,85 @@
+// Package api wires HTTP handlers to a store.Store. Handlers take their
+// dependencies through Server so they can be exercised in tests against an
+// in-memory store instead of a live Postgres.
+package api
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/rs/cors"
+	"net/http"
+
+	"qr-menu-app/internal/models"
+	"qr-menu-app/internal/observability"
+	"qr-menu-app/internal/payments"
+	"qr-menu-app/internal/store"
+	"qr-menu-app/internal/ws"
+)
+
+// Server holds the dependencies every handler needs.
+type Server struct {
+	store   store.Store
+	hub     *ws.Hub
+	gateway payments.Gateway
+}
+
+// NewServer constructs a Server from its Store, Hub and payment Gateway.
+// Handlers are methods on Server so they close over these instead of
+// reaching for package-level globals.
+func NewServer(s store.Store, hub *ws.Hub, gateway payments.Gateway) *Server {
+	return &Server{store: s, hub: hub, gateway: gateway}
+}
+
+// Router builds the full mux.Router, including CORS and auth middleware.
+func (s *Server) Router() http.Handler {
+	router := mux.NewRouter()
+	router.Use(observability.RequestLogging, observability.Metrics)
+	router.HandleFunc("/ws", s.handleWebSocket)
+	router.Handle("/metrics", observability.Handler()).Methods("GET")
+
+	api := router.PathPrefix("/api").Subrouter()
+
+	// Auth routes - unauthenticated, issue the tokens everything else needs.
+	api.HandleFunc("/auth/login", s.handleLogin).Methods("POST")
+	api.HandleFunc("/auth/refresh", s.handleRefresh).Methods("POST")
+
+	// Restaurant routes. Browsing a restaurant's public profile and signing
+	// up a new tenant stay open; anything that mutates an existing tenant's
+	// settings requires staff auth scoped to that restaurant.
+	api.HandleFunc("/restaurants", s.getRestaurants).Methods("GET")
+	api.HandleFunc("/restaurants", s.createRestaurant).Methods("POST")
+	api.HandleFunc("/restaurants/{id:[0-9]+}", s.requireAuth(models.RoleOwner, models.RoleManager)(s.updateRestaurant)).Methods("PATCH")
+	api.HandleFunc("/restaurant/{id:[0-9]+}", s.getRestaurant).Methods("GET")
+	api.HandleFunc("/restaurant/{id:[0-9]+}/categories", s.getMenuCategories).Methods("GET")
+	api.HandleFunc("/restaurant/{id:[0-9]+}/menu", s.getMenuItems).Methods("GET")
+	api.HandleFunc("/restaurant/{id:[0-9]+}/orders", s.requireAuth(models.RoleOwner, models.RoleManager, models.RoleStaff, models.RoleKitchen)(s.getOrders)).Methods("GET")
+	api.HandleFunc("/restaurant/{id:[0-9]+}/stats", s.requireAuth(models.RoleOwner, models.RoleManager)(s.getStats)).Methods("GET")
+
+	// Subscription routes - the upgrade flow is two steps so the frontend can
+	// open gateway checkout with a real order id, then confirm the signed
+	// result; the webhook below is the backstop if the confirm call never
+	// arrives.
+	api.HandleFunc("/restaurant/{id:[0-9]+}/subscription/intent", s.requireAuth(models.RoleOwner)(s.createSubscriptionIntent)).Methods("POST")
+	api.HandleFunc("/restaurant/{id:[0-9]+}/subscription/confirm", s.requireAuth(models.RoleOwner)(s.confirmSubscription)).Methods("POST")
+	api.HandleFunc("/webhooks/razorpay", s.handleRazorpayWebhook).Methods("POST")
+
+	// Menu item routes - editing the menu is staff-only; browsing it is the
+	// public /restaurant/{id}/menu route above.
+	api.HandleFunc("/menu-items", s.requireAuth(models.RoleOwner, models.RoleManager)(s.createMenuItem)).Methods("POST")
+	api.HandleFunc("/menu-items/{id:[0-9]+}", s.requireAuth(models.RoleOwner, models.RoleManager)(s.updateMenuItem)).Methods("PATCH")
+	api.HandleFunc("/menu-items/{id:[0-9]+}", s.requireAuth(models.RoleOwner, models.RoleManager)(s.deleteMenuItem)).Methods("DELETE")
+
+	// Order routes. Customers create orders anonymously via the table QR
+	// code and check status by order number; updating status is staff-only.
+	api.HandleFunc("/orders", s.createOrder).Methods("POST")
+	api.HandleFunc("/orders/{id:[0-9]+}/status", s.requireAuth(models.RoleOwner, models.RoleManager, models.RoleStaff, models.RoleKitchen)(s.updateOrderStatus)).Methods("PATCH")
+	api.HandleFunc("/orders/by-number/{orderNumber}", s.getOrderByNumber).Methods("GET")
+
+	c := cors.New(cors.Options{
+		AllowedOrigins:   []string{"*"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "PATCH", "OPTIONS"},
+		AllowedHeaders:   []string{"*"},
+		AllowCredentials: true,
+	})
+
+	return c.Handler(router)
+}
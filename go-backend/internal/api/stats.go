@@ -0,0 +1,85 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"qr-menu-app/internal/store"
+)
+
+// defaultStatsWindow is how far back /stats looks when the caller doesn't
+// pass ?from=: the 24 hours before ?to= (or now), a rolling window rather
+// than the calendar day the old getStats reported on.
+const defaultStatsWindow = 24 * time.Hour
+
+var (
+	errInvalidBucket    = errors.New("bucket must be one of hour, day, week")
+	errInvalidDateRange = errors.New("from/to must be RFC3339 timestamps")
+)
+
+func (s *Server) getStats(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	restaurantID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid restaurant ID", http.StatusBadRequest)
+		return
+	}
+	if !restaurantPathMatches(r.Context(), restaurantID) {
+		http.Error(w, "Restaurant mismatch", http.StatusForbidden)
+		return
+	}
+
+	query, err := parseStatsQuery(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	stats, err := s.store.GetStats(r.Context(), uint(restaurantID), query)
+	if err != nil {
+		http.Error(w, "Failed to fetch stats", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(stats)
+}
+
+func parseStatsQuery(params url.Values) (store.StatsQuery, error) {
+	get := params.Get
+
+	q := store.StatsQuery{
+		Bucket: get("bucket"),
+		To:     time.Now(),
+	}
+	if q.Bucket == "" {
+		q.Bucket = store.BucketDay
+	}
+	if q.Bucket != store.BucketHour && q.Bucket != store.BucketDay && q.Bucket != store.BucketWeek {
+		return store.StatsQuery{}, errInvalidBucket
+	}
+
+	if raw := get("to"); raw != "" {
+		to, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return store.StatsQuery{}, errInvalidDateRange
+		}
+		q.To = to
+	}
+	q.From = q.To.Add(-defaultStatsWindow)
+	if raw := get("from"); raw != "" {
+		from, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return store.StatsQuery{}, errInvalidDateRange
+		}
+		q.From = from
+	}
+
+	return q, nil
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+
+	"qr-menu-app/internal/store"
+)
+
+func TestParseStatsQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantBucket string
+		wantErr    bool
+	}{
+		{name: "defaults to day bucket", query: "", wantBucket: store.BucketDay},
+		{name: "accepts hour bucket", query: "bucket=hour", wantBucket: store.BucketHour},
+		{name: "rejects unknown bucket", query: "bucket=fortnight", wantErr: true},
+		{name: "rejects malformed from", query: "from=not-a-date", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatalf("ParseQuery(%q): %v", tt.query, err)
+			}
+
+			q, err := parseStatsQuery(params)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if q.Bucket != tt.wantBucket {
+				t.Errorf("Bucket = %q, want %q", q.Bucket, tt.wantBucket)
+			}
+			if !q.From.Before(q.To) {
+				t.Errorf("From (%v) should be before To (%v)", q.From, q.To)
+			}
+		})
+	}
+}
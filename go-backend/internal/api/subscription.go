@@ -0,0 +1,171 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"qr-menu-app/internal/models"
+	"qr-menu-app/internal/payments"
+	"qr-menu-app/internal/store"
+)
+
+// createSubscriptionIntent opens a payment gateway order for the restaurant's
+// monthly plan and hands back what the frontend needs to launch checkout.
+// Nothing about the subscription is updated yet - that only happens once
+// confirmSubscription (or the webhook) sees a signed confirmation.
+func (s *Server) createSubscriptionIntent(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid restaurant ID", http.StatusBadRequest)
+		return
+	}
+	if !restaurantPathMatches(r.Context(), id) {
+		http.Error(w, "Restaurant mismatch", http.StatusForbidden)
+		return
+	}
+
+	var req struct {
+		PlanType string `json:"planType"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	intent, err := s.gateway.CreateOrder(payments.DefaultPlanAmountPaise, "INR", req.PlanType)
+	if err != nil {
+		http.Error(w, "Failed to start payment", http.StatusBadGateway)
+		return
+	}
+
+	payment := models.Payment{
+		RestaurantID:   uint(id),
+		PlanType:       req.PlanType,
+		Amount:         strconv.FormatInt(intent.Amount, 10),
+		Currency:       intent.Currency,
+		GatewayOrderID: intent.OrderID,
+		Status:         models.PaymentStatusCreated,
+	}
+	if err := s.store.CreatePayment(r.Context(), &payment); err != nil {
+		http.Error(w, "Failed to record payment", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(intent)
+}
+
+// confirmSubscription verifies the checkout callback's signature and, once
+// verified, activates the subscription immediately rather than waiting on
+// the webhook - Razorpay's webhook delivery can lag by several seconds and
+// the owner is sitting on the upgrade screen waiting for a result.
+func (s *Server) confirmSubscription(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid restaurant ID", http.StatusBadRequest)
+		return
+	}
+	if !restaurantPathMatches(r.Context(), id) {
+		http.Error(w, "Restaurant mismatch", http.StatusForbidden)
+		return
+	}
+
+	var req struct {
+		OrderID   string `json:"orderId"`
+		PaymentID string `json:"paymentId"`
+		Signature string `json:"signature"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.gateway.VerifySignature(req.OrderID, req.PaymentID, req.Signature); err != nil {
+		http.Error(w, "Invalid payment signature", http.StatusForbidden)
+		return
+	}
+
+	payment, err := s.store.GetPaymentByGatewayOrderID(r.Context(), req.OrderID)
+	if err != nil {
+		http.Error(w, "Payment not found", http.StatusNotFound)
+		return
+	}
+	if payment.RestaurantID != uint(id) {
+		http.Error(w, "Restaurant mismatch", http.StatusForbidden)
+		return
+	}
+
+	if err := s.store.UpdatePaymentStatus(r.Context(), payment.ID, models.PaymentStatusCaptured, &req.PaymentID); err != nil {
+		http.Error(w, "Failed to update payment", http.StatusInternalServerError)
+		return
+	}
+
+	restaurant, err := s.store.ActivateSubscription(r.Context(), uint(id), payment.PlanType, time.Now().AddDate(0, 1, 0))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			http.Error(w, "Restaurant not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to activate subscription", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(restaurant)
+}
+
+// handleRazorpayWebhook is the backstop for confirmSubscription: Razorpay
+// calls this directly, so a subscription still activates even if the
+// customer closes the tab before the confirm request lands.
+func (s *Server) handleRazorpayWebhook(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "Failed to read request body", http.StatusBadRequest)
+		return
+	}
+
+	event, err := s.gateway.HandleWebhook(body, r.Header.Get("X-Razorpay-Signature"))
+	if err != nil {
+		http.Error(w, "Invalid webhook signature", http.StatusForbidden)
+		return
+	}
+
+	payment, err := s.store.GetPaymentByGatewayOrderID(r.Context(), event.OrderID)
+	if err != nil {
+		// Nothing we created this payment for - acknowledge anyway so
+		// Razorpay doesn't retry an event we'll never be able to match.
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	switch event.Type {
+	case "subscription.charged":
+		if err := s.store.UpdatePaymentStatus(r.Context(), payment.ID, models.PaymentStatusCaptured, &event.PaymentID); err != nil {
+			http.Error(w, "Failed to update payment", http.StatusInternalServerError)
+			return
+		}
+		if _, err := s.store.ActivateSubscription(r.Context(), payment.RestaurantID, payment.PlanType, time.Now().AddDate(0, 1, 0)); err != nil {
+			http.Error(w, "Failed to update restaurant", http.StatusInternalServerError)
+			return
+		}
+	case "subscription.halted":
+		if err := s.store.UpdatePaymentStatus(r.Context(), payment.ID, models.PaymentStatusFailed, &event.PaymentID); err != nil {
+			http.Error(w, "Failed to update payment", http.StatusInternalServerError)
+			return
+		}
+		if err := s.store.SetRestaurantActive(r.Context(), payment.RestaurantID, false); err != nil {
+			http.Error(w, "Failed to update restaurant", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"qr-menu-app/internal/observability"
+)
+
+// handleWebSocket resolves which restaurant room the connection joins - the
+// JWT if the client sent one (a POS terminal), otherwise the restaurantId
+// query param (a kitchen display or QR customer page) - then hands off to
+// the hub.
+func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	restaurantID, ok := resolveWSRestaurantID(r)
+	if !ok {
+		http.Error(w, "restaurantId is required", http.StatusBadRequest)
+		return
+	}
+	observability.SetRestaurantID(r.Context(), restaurantID)
+
+	s.hub.ServeWS(w, r, restaurantID)
+}
+
+func resolveWSRestaurantID(r *http.Request) (uint, bool) {
+	if authHeader := r.Header.Get("Authorization"); strings.HasPrefix(authHeader, "Bearer ") {
+		if c, err := parseToken(strings.TrimPrefix(authHeader, "Bearer "), tokenTypeAccess); err == nil {
+			return c.RestaurantID, true
+		}
+	}
+
+	raw := r.URL.Query().Get("restaurantId")
+	if raw == "" {
+		return 0, false
+	}
+	id, err := strconv.Atoi(raw)
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return uint(id), true
+}
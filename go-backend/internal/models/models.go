@@ -0,0 +1,143 @@
+// Package models holds the GORM-backed domain types shared by internal/store
+// and internal/api. It has no dependency on either, so it can be imported
+// freely from both sides of the store interface.
+package models
+
+import "time"
+
+type Restaurant struct {
+	ID                  uint      `json:"id" gorm:"primaryKey"`
+	Name                string    `json:"name" gorm:"not null"`
+	Slug                string    `json:"slug" gorm:"unique;not null"`
+	Description         *string   `json:"description"`
+	Address             *string   `json:"address"`
+	Phone               *string   `json:"phone"`
+	Email               *string   `json:"email"`
+	Logo                *string   `json:"logo"`
+	PrimaryColor        string    `json:"primaryColor" gorm:"default:#FF6B35"`
+	SecondaryColor      string    `json:"secondaryColor" gorm:"default:#C62828"`
+	AccentColor         string    `json:"accentColor" gorm:"default:#FFB300"`
+	TableCount          int       `json:"tableCount" gorm:"default:15"`
+	ServiceCharge       string    `json:"serviceCharge" gorm:"default:10.00"`
+	GST                 string    `json:"gst" gorm:"default:5.00"`
+	OrderModes          []string  `json:"orderModes" gorm:"type:text[]"`
+	IsActive            bool      `json:"isActive" gorm:"default:true"`
+	TrialStartDate      time.Time `json:"trialStartDate" gorm:"default:CURRENT_TIMESTAMP"`
+	SubscriptionEndDate *time.Time `json:"subscriptionEndDate"`
+	PlanType            string    `json:"planType" gorm:"default:trial"`
+	MonthlyRate         string    `json:"monthlyRate" gorm:"default:4999.00"`
+	CreatedAt           time.Time `json:"createdAt"`
+	UpdatedAt           time.Time `json:"updatedAt"`
+}
+
+type MenuCategory struct {
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	RestaurantID uint      `json:"restaurantId"`
+	Name         string    `json:"name" gorm:"not null"`
+	DisplayOrder int       `json:"displayOrder" gorm:"default:0"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+type MenuItem struct {
+	ID              uint      `json:"id" gorm:"primaryKey"`
+	RestaurantID    uint      `json:"restaurantId"`
+	CategoryID      uint      `json:"categoryId"`
+	Name            string    `json:"name" gorm:"not null"`
+	Description     *string   `json:"description"`
+	Price           string    `json:"price" gorm:"not null"`
+	ImageURL        *string   `json:"imageUrl"`
+	IsVeg           bool      `json:"isVeg" gorm:"default:true"`
+	IsPopular       bool      `json:"isPopular" gorm:"default:false"`
+	IsAvailable     bool      `json:"isAvailable" gorm:"default:true"`
+	PreparationTime *int      `json:"preparationTime" gorm:"default:15"`
+	DisplayOrder    int       `json:"displayOrder" gorm:"default:0"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+type OrderItem struct {
+	ID       int     `json:"id"`
+	Name     string  `json:"name"`
+	Price    string  `json:"price"`
+	Quantity int     `json:"quantity"`
+	Total    string  `json:"total"`
+	Notes    *string `json:"notes,omitempty"`
+}
+
+type Order struct {
+	ID            uint        `json:"id" gorm:"primaryKey"`
+	RestaurantID  uint        `json:"restaurantId"`
+	OrderNumber   string      `json:"orderNumber" gorm:"unique;not null"`
+	OrderType     string      `json:"orderType" gorm:"not null"`
+	TableNumber   *string     `json:"tableNumber"`
+	CustomerName  *string     `json:"customerName"`
+	CustomerPhone *string     `json:"customerPhone"`
+	Items         []OrderItem `json:"items" gorm:"type:jsonb"`
+	Subtotal      string      `json:"subtotal" gorm:"not null"`
+	ServiceCharge string      `json:"serviceCharge" gorm:"not null"`
+	GST           string      `json:"gst" gorm:"not null"`
+	Total         string      `json:"total" gorm:"not null"`
+	Status        string      `json:"status" gorm:"default:pending"`
+	Notes         *string     `json:"notes"`
+	CreatedAt     time.Time   `json:"createdAt"`
+	UpdatedAt     time.Time   `json:"updatedAt"`
+}
+
+// User is a staff login tied to exactly one restaurant. Customers never get a
+// User row - they interact through the public slug/table routes.
+type User struct {
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	RestaurantID uint      `json:"restaurantId" gorm:"not null;index"`
+	Email        string    `json:"email" gorm:"unique;not null"`
+	PasswordHash string    `json:"-" gorm:"not null"`
+	Role         string    `json:"role" gorm:"not null"`
+	Name         string    `json:"name"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// Roles a User can hold within its restaurant.
+const (
+	RoleOwner   = "owner"
+	RoleManager = "manager"
+	RoleStaff   = "staff"
+	RoleKitchen = "kitchen"
+)
+
+// OrderIdempotencyKey records the Idempotency-Key a client sent with an
+// order creation request, so a retried POST (a flaky mobile network is the
+// common case) returns the original order instead of creating a duplicate.
+type OrderIdempotencyKey struct {
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	RestaurantID uint      `json:"restaurantId" gorm:"not null;uniqueIndex:idx_order_idempotency_key"`
+	Key          string    `json:"key" gorm:"not null;uniqueIndex:idx_order_idempotency_key"`
+	OrderID      uint      `json:"orderId" gorm:"not null"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+func (OrderIdempotencyKey) TableName() string {
+	return "order_idempotency"
+}
+
+// Payment statuses a subscription payment moves through.
+const (
+	PaymentStatusCreated  = "created"
+	PaymentStatusCaptured = "captured"
+	PaymentStatusFailed   = "failed"
+)
+
+// Payment is one subscription charge attempt against the payment gateway,
+// from the intent the frontend opens checkout with through to the
+// confirm/webhook call that settles it.
+type Payment struct {
+	ID               uint      `json:"id" gorm:"primaryKey"`
+	RestaurantID     uint      `json:"restaurantId" gorm:"not null;index"`
+	PlanType         string    `json:"planType" gorm:"not null"`
+	Amount           string    `json:"amount" gorm:"not null"`
+	Currency         string    `json:"currency" gorm:"not null"`
+	GatewayOrderID   string    `json:"gatewayOrderId" gorm:"not null;uniqueIndex"`
+	GatewayPaymentID *string   `json:"gatewayPaymentId"`
+	Status           string    `json:"status" gorm:"not null;default:created"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,70 @@
+// Package observability provides the structured request logging and
+// Prometheus metrics middleware shared by every HTTP and WebSocket entry
+// point, so neither internal/api nor internal/ws has to reach for
+// package-level loggers or counters directly.
+package observability
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+)
+
+type contextKey string
+
+const (
+	requestIDContextKey contextKey = "requestId"
+	loggerContextKey    contextKey = "logger"
+	requestInfoKey      contextKey = "requestInfo"
+)
+
+// RequestIDHeader is both the header RequestLogging reads an inbound request
+// id from and the one it echoes back, so a request can be traced across
+// services that forward it.
+const RequestIDHeader = "X-Request-ID"
+
+// requestInfo carries access-log fields that aren't known until deeper in
+// the handler chain - restaurant_id isn't resolved until the auth middleware
+// parses the token, well after RequestLogging has already started timing the
+// request. It's addressed through a pointer stashed in the context so a
+// downstream handler can fill it in and RequestLogging can read it back once
+// the handler returns.
+type requestInfo struct {
+	restaurantID uint
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// RequestIDFromContext returns the request id RequestLogging attached, or ""
+// if called outside a request (e.g. from a test that never ran the
+// middleware).
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey).(string)
+	return id
+}
+
+// LoggerFromContext returns the per-request slog.Logger RequestLogging
+// attached, falling back to slog.Default() so callers never need a nil
+// check.
+func LoggerFromContext(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(loggerContextKey).(*slog.Logger); ok {
+		return l
+	}
+	return slog.Default()
+}
+
+// SetRestaurantID records the authenticated tenant for the current request's
+// access log line. Called from the auth middleware and from the WebSocket
+// handler; a no-op if RequestLogging isn't in the middleware chain.
+func SetRestaurantID(ctx context.Context, restaurantID uint) {
+	if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok {
+		info.restaurantID = restaurantID
+	}
+}
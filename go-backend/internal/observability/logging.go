@@ -0,0 +1,94 @@
+package observability
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// statusWriter wraps http.ResponseWriter to capture the status code and byte
+// count a handler actually wrote, since neither is otherwise observable once
+// the handler returns.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
+
+// Hijack passes through to the wrapped ResponseWriter so the WebSocket
+// upgrade on /ws can take over the connection behind this middleware.
+func (w *statusWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("observability: wrapped ResponseWriter does not support hijacking")
+	}
+	return h.Hijack()
+}
+
+// RequestLogging generates (or propagates) an X-Request-ID, attaches a
+// request-scoped slog.Logger to the context, and emits one structured log
+// line per request once it completes. Register it first in the middleware
+// chain so Metrics and every handler downstream can see the request id and
+// logger it attaches.
+func RequestLogging(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(RequestIDHeader)
+		if requestID == "" {
+			requestID = newRequestID()
+		}
+		w.Header().Set(RequestIDHeader, requestID)
+
+		logger := slog.With("request_id", requestID)
+		info := &requestInfo{}
+
+		ctx := context.WithValue(r.Context(), requestIDContextKey, requestID)
+		ctx = context.WithValue(ctx, loggerContextKey, logger)
+		ctx = context.WithValue(ctx, requestInfoKey, info)
+		r = r.WithContext(ctx)
+
+		sw := &statusWriter{ResponseWriter: w}
+		start := time.Now()
+		next.ServeHTTP(sw, r)
+
+		logger.Info("request completed",
+			"method", r.Method,
+			"route", routeTemplate(r),
+			"status", sw.status,
+			"bytes", sw.bytes,
+			"duration_ms", time.Since(start).Milliseconds(),
+			"restaurant_id", info.restaurantID,
+		)
+	})
+}
+
+// routeTemplate prefers the matched mux route's path template (e.g.
+// "/api/restaurant/{id}/orders") over the raw URL so access logs and metrics
+// group by endpoint instead of by every distinct restaurant id.
+func routeTemplate(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if tpl, err := route.GetPathTemplate(); err == nil {
+			return tpl
+		}
+	}
+	return r.URL.Path
+}
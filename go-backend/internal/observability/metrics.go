@@ -0,0 +1,80 @@
+package observability
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	httpRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Total HTTP requests by route, method and status code.",
+	}, []string{"route", "method", "status"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "HTTP request latency in seconds by route and method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route", "method"})
+
+	websocketConnections = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "websocket_connections",
+		Help: "Currently open WebSocket connections by restaurant.",
+	}, []string{"restaurant_id"})
+
+	ordersCreatedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "orders_created_total",
+		Help: "Total orders created by restaurant and order type.",
+	}, []string{"restaurant_id", "order_type"})
+)
+
+// Metrics records request count and latency for every request that passes
+// through it. Route label cardinality is bounded by the mux path template,
+// never the raw URL, so it doesn't grow with the number of restaurants.
+// Register it after RequestLogging so it reuses the same statusWriter.
+func Metrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sw, ok := w.(*statusWriter)
+		if !ok {
+			sw = &statusWriter{ResponseWriter: w}
+		}
+
+		start := time.Now()
+		next.ServeHTTP(sw, r)
+
+		route := routeTemplate(r)
+		httpRequestsTotal.WithLabelValues(route, r.Method, strconv.Itoa(sw.status)).Inc()
+		httpRequestDuration.WithLabelValues(route, r.Method).Observe(time.Since(start).Seconds())
+	})
+}
+
+// Handler exposes the /metrics endpoint for Prometheus to scrape.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// RecordWebSocketConnect and RecordWebSocketDisconnect track the
+// websocket_connections gauge; internal/ws calls these from its
+// register/unregister methods.
+func RecordWebSocketConnect(restaurantID uint) {
+	websocketConnections.WithLabelValues(restaurantIDLabel(restaurantID)).Inc()
+}
+
+func RecordWebSocketDisconnect(restaurantID uint) {
+	websocketConnections.WithLabelValues(restaurantIDLabel(restaurantID)).Dec()
+}
+
+// RecordOrderCreated increments orders_created_total; internal/api's
+// createOrder handler calls this once an order actually persists.
+func RecordOrderCreated(restaurantID uint, orderType string) {
+	ordersCreatedTotal.WithLabelValues(restaurantIDLabel(restaurantID), orderType).Inc()
+}
+
+func restaurantIDLabel(restaurantID uint) string {
+	return strconv.FormatUint(uint64(restaurantID), 10)
+}
@@ -0,0 +1,39 @@
+// Package payments abstracts the subscription payment gateway behind a small
+// interface so the upgrade flow doesn't hard-code one provider's SDK into
+// internal/api. Razorpay is the only implementation today since it's the
+// default for this India-focused deployment.
+package payments
+
+import "errors"
+
+// ErrInvalidSignature is returned by VerifySignature and HandleWebhook when
+// the HMAC doesn't match - either a tampered request or a misconfigured
+// secret, never something a handler should retry.
+var ErrInvalidSignature = errors.New("payments: invalid signature")
+
+// PaymentIntent is what CreateOrder hands back for the frontend to open
+// checkout with.
+type PaymentIntent struct {
+	OrderID  string
+	Amount   int64 // smallest currency unit - paise for INR
+	Currency string
+	PlanID   string
+}
+
+// Event is a normalized webhook notification. Type is the gateway's own
+// event name (e.g. "subscription.charged") so callers can switch on it
+// without this package needing to know what each one means.
+type Event struct {
+	Type      string
+	OrderID   string
+	PaymentID string
+}
+
+// Gateway is the seam between internal/api and whichever payment provider is
+// configured. A fake implementation satisfying this interface is enough to
+// test the upgrade flow without hitting a real gateway.
+type Gateway interface {
+	CreateOrder(amount int64, currency string, planID string) (*PaymentIntent, error)
+	VerifySignature(orderID, paymentID, signature string) error
+	HandleWebhook(body []byte, signature string) (*Event, error)
+}
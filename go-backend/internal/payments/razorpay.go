@@ -0,0 +1,136 @@
+package payments
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// DefaultPlanAmountPaise is the ₹4999.00 default monthly rate (Restaurant's
+// MonthlyRate field), expressed in paise as Razorpay's API requires.
+const DefaultPlanAmountPaise int64 = 499900
+
+const razorpayOrdersURL = "https://api.razorpay.com/v1/orders"
+
+// RazorpayGateway talks to the real Razorpay API. keySecret signs/verifies
+// payment signatures; webhookSecret is the separate secret configured on the
+// Razorpay dashboard for the webhook endpoint.
+type RazorpayGateway struct {
+	keyID         string
+	keySecret     string
+	webhookSecret string
+	httpClient    *http.Client
+}
+
+// NewRazorpayGateway constructs a Gateway from the account's API credentials.
+func NewRazorpayGateway(keyID, keySecret, webhookSecret string) *RazorpayGateway {
+	return &RazorpayGateway{
+		keyID:         keyID,
+		keySecret:     keySecret,
+		webhookSecret: webhookSecret,
+		httpClient:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type razorpayOrderResponse struct {
+	ID       string `json:"id"`
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// CreateOrder calls Razorpay's Orders API so the frontend has an order_id to
+// open checkout with.
+func (g *RazorpayGateway) CreateOrder(amount int64, currency string, planID string) (*PaymentIntent, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"amount":   amount,
+		"currency": currency,
+		"receipt":  fmt.Sprintf("plan-%s-%d", planID, time.Now().Unix()),
+		"notes":    map[string]string{"planId": planID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal order request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, razorpayOrdersURL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("build order request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(g.keyID, g.keySecret)
+
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("call razorpay: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("razorpay order creation failed with status %d", resp.StatusCode)
+	}
+
+	var order razorpayOrderResponse
+	if err := json.NewDecoder(resp.Body).Decode(&order); err != nil {
+		return nil, fmt.Errorf("decode razorpay response: %w", err)
+	}
+
+	return &PaymentIntent{
+		OrderID:  order.ID,
+		Amount:   order.Amount,
+		Currency: order.Currency,
+		PlanID:   planID,
+	}, nil
+}
+
+// VerifySignature checks the checkout callback's signature: Razorpay defines
+// it as hmac_sha256(order_id + "|" + payment_id, key_secret).
+func (g *RazorpayGateway) VerifySignature(orderID, paymentID, signature string) error {
+	expected := sign(g.keySecret, orderID+"|"+paymentID)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+type razorpayWebhookPayload struct {
+	Event   string `json:"event"`
+	Payload struct {
+		Payment struct {
+			Entity struct {
+				ID     string `json:"id"`
+				OrderID string `json:"order_id"`
+			} `json:"entity"`
+		} `json:"payment"`
+	} `json:"payload"`
+}
+
+// HandleWebhook verifies the X-Razorpay-Signature header against the raw
+// body using the webhook secret (a different secret from the one that signs
+// checkout callbacks) and parses out the event type and IDs.
+func (g *RazorpayGateway) HandleWebhook(body []byte, signature string) (*Event, error) {
+	expected := sign(g.webhookSecret, string(body))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return nil, ErrInvalidSignature
+	}
+
+	var payload razorpayWebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("decode webhook payload: %w", err)
+	}
+
+	return &Event{
+		Type:      payload.Event,
+		OrderID:   payload.Payload.Payment.Entity.OrderID,
+		PaymentID: payload.Payload.Payment.Entity.ID,
+	}, nil
+}
+
+func sign(secret, message string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(message))
+	return hex.EncodeToString(mac.Sum(nil))
+}
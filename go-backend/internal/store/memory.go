@@ -0,0 +1,576 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"qr-menu-app/internal/models"
+)
+
+// memoryStore is an in-process Store used by internal/api's handler tests so
+// they don't need a live Postgres. It's intentionally simple: a mutex and a
+// few maps, no query planner.
+type memoryStore struct {
+	mu sync.Mutex
+
+	restaurants map[uint]models.Restaurant
+	users       map[uint]models.User
+	categories  map[uint]models.MenuCategory
+	menuItems   map[uint]models.MenuItem
+	orders      map[uint]models.Order
+	// idempotencyKeys maps "restaurantID:key" to the order it originally
+	// created, mirroring the unique index on order_idempotency.
+	idempotencyKeys map[string]uint
+	payments        map[uint]models.Payment
+
+	nextRestaurantID uint
+	nextMenuItemID   uint
+	nextOrderID      uint
+	nextPaymentID    uint
+}
+
+// NewMemoryStore returns an empty Store suitable for handler tests.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		restaurants:     make(map[uint]models.Restaurant),
+		users:           make(map[uint]models.User),
+		categories:      make(map[uint]models.MenuCategory),
+		menuItems:       make(map[uint]models.MenuItem),
+		orders:          make(map[uint]models.Order),
+		idempotencyKeys: make(map[string]uint),
+		payments:        make(map[uint]models.Payment),
+	}
+}
+
+func (s *memoryStore) ListRestaurants(ctx context.Context) ([]models.Restaurant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]models.Restaurant, 0, len(s.restaurants))
+	for _, r := range s.restaurants {
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func (s *memoryStore) CreateRestaurant(ctx context.Context, r *models.Restaurant) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextRestaurantID++
+	r.ID = s.nextRestaurantID
+	s.restaurants[r.ID] = *r
+	return nil
+}
+
+func (s *memoryStore) GetRestaurant(ctx context.Context, id uint) (*models.Restaurant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.restaurants[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &r, nil
+}
+
+func (s *memoryStore) UpdateRestaurant(ctx context.Context, id uint, updates map[string]interface{}) (*models.Restaurant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.restaurants[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	applyRestaurantUpdates(&r, updates)
+	s.restaurants[id] = r
+	return &r, nil
+}
+
+// applyRestaurantUpdates mirrors postgresStore.UpdateRestaurant: only
+// restaurantEditableColumns may be set this way, so the two stores behave
+// identically and a test double can't hide a whitelist bug. Billing columns
+// (plan_type, subscription_end_date, is_active, ...) aren't writable here.
+func applyRestaurantUpdates(r *models.Restaurant, updates map[string]interface{}) {
+	for k, v := range updates {
+		if !restaurantEditableColumns[k] {
+			continue
+		}
+		switch k {
+		case "name":
+			if s, ok := v.(string); ok {
+				r.Name = s
+			}
+		case "description":
+			if s, ok := v.(string); ok {
+				r.Description = &s
+			}
+		case "address":
+			if s, ok := v.(string); ok {
+				r.Address = &s
+			}
+		case "phone":
+			if s, ok := v.(string); ok {
+				r.Phone = &s
+			}
+		case "email":
+			if s, ok := v.(string); ok {
+				r.Email = &s
+			}
+		case "logo":
+			if s, ok := v.(string); ok {
+				r.Logo = &s
+			}
+		case "primary_color":
+			if s, ok := v.(string); ok {
+				r.PrimaryColor = s
+			}
+		case "secondary_color":
+			if s, ok := v.(string); ok {
+				r.SecondaryColor = s
+			}
+		case "accent_color":
+			if s, ok := v.(string); ok {
+				r.AccentColor = s
+			}
+		case "table_count":
+			if n, ok := v.(float64); ok {
+				r.TableCount = int(n)
+			}
+		case "service_charge":
+			if s, ok := v.(string); ok {
+				r.ServiceCharge = s
+			}
+		case "gst":
+			if s, ok := v.(string); ok {
+				r.GST = s
+			}
+		case "order_modes":
+			if arr, ok := v.([]interface{}); ok {
+				modes := make([]string, 0, len(arr))
+				for _, m := range arr {
+					if s, ok := m.(string); ok {
+						modes = append(modes, s)
+					}
+				}
+				r.OrderModes = modes
+			}
+		}
+	}
+}
+
+// ActivateSubscription mirrors postgresStore.ActivateSubscription.
+func (s *memoryStore) ActivateSubscription(ctx context.Context, id uint, planType string, endDate time.Time) (*models.Restaurant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.restaurants[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	r.PlanType = planType
+	r.SubscriptionEndDate = &endDate
+	r.IsActive = true
+	s.restaurants[id] = r
+	return &r, nil
+}
+
+// SetRestaurantActive mirrors postgresStore.SetRestaurantActive.
+func (s *memoryStore) SetRestaurantActive(ctx context.Context, id uint, active bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.restaurants[id]
+	if !ok {
+		return ErrNotFound
+	}
+	r.IsActive = active
+	s.restaurants[id] = r
+	return nil
+}
+
+func (s *memoryStore) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, u := range s.users {
+		if u.Email == email {
+			return &u, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (s *memoryStore) GetUserByID(ctx context.Context, id uint) (*models.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, ok := s.users[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &u, nil
+}
+
+func (s *memoryStore) ListMenuCategories(ctx context.Context, restaurantID uint) ([]models.MenuCategory, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]models.MenuCategory, 0)
+	for _, c := range s.categories {
+		if c.RestaurantID == restaurantID {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func (s *memoryStore) ListMenuItems(ctx context.Context, restaurantID uint, categoryID uint) ([]models.MenuItem, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]models.MenuItem, 0)
+	for _, item := range s.menuItems {
+		if item.RestaurantID != restaurantID {
+			continue
+		}
+		if categoryID != 0 && item.CategoryID != categoryID {
+			continue
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+func (s *memoryStore) CreateMenuItem(ctx context.Context, item *models.MenuItem) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextMenuItemID++
+	item.ID = s.nextMenuItemID
+	item.IsAvailable = true
+	s.menuItems[item.ID] = *item
+	return nil
+}
+
+func (s *memoryStore) UpdateMenuItem(ctx context.Context, restaurantID, id uint, updates map[string]interface{}) (*models.MenuItem, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	item, ok := s.menuItems[id]
+	if !ok || item.RestaurantID != restaurantID {
+		return nil, ErrNotFound
+	}
+	if v, ok := updates["name"].(string); ok {
+		item.Name = v
+	}
+	if v, ok := updates["price"].(string); ok {
+		item.Price = v
+	}
+	if v, ok := updates["isAvailable"].(bool); ok {
+		item.IsAvailable = v
+	}
+	s.menuItems[id] = item
+	return &item, nil
+}
+
+func (s *memoryStore) SetMenuItemAvailability(ctx context.Context, restaurantID, id uint, available bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	item, ok := s.menuItems[id]
+	if !ok || item.RestaurantID != restaurantID {
+		return ErrNotFound
+	}
+	item.IsAvailable = available
+	s.menuItems[id] = item
+	return nil
+}
+
+func (s *memoryStore) ListOrders(ctx context.Context, restaurantID uint, status string) ([]models.Order, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]models.Order, 0)
+	for _, o := range s.orders {
+		if o.RestaurantID != restaurantID {
+			continue
+		}
+		if status != "" && o.Status != status {
+			continue
+		}
+		out = append(out, o)
+	}
+	return out, nil
+}
+
+func (s *memoryStore) CreateOrder(ctx context.Context, input CreateOrderInput) (*models.Order, error) {
+	if len(input.Items) == 0 {
+		return nil, ErrOrderEmpty
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idemKey := idempotencyMapKey(input.RestaurantID, input.IdempotencyKey)
+	if input.IdempotencyKey != "" {
+		if orderID, ok := s.idempotencyKeys[idemKey]; ok {
+			existing := s.orders[orderID]
+			return &existing, nil
+		}
+	}
+
+	restaurant, ok := s.restaurants[input.RestaurantID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	items := make([]models.OrderItem, 0, len(input.Items))
+	var subtotal float64
+	for _, line := range input.Items {
+		if line.Quantity <= 0 {
+			return nil, ErrInvalidQuantity
+		}
+		menuItem, ok := s.menuItems[line.MenuItemID]
+		if !ok || menuItem.RestaurantID != input.RestaurantID {
+			return nil, ErrNotFound
+		}
+		if !menuItem.IsAvailable {
+			return nil, ErrItemUnavailable
+		}
+		price, _ := strconv.ParseFloat(menuItem.Price, 64)
+		lineTotal := price * float64(line.Quantity)
+		subtotal += lineTotal
+
+		var notes *string
+		if noteText := line.Notes; noteText != "" {
+			notes = &noteText
+		}
+		items = append(items, models.OrderItem{
+			ID:       int(menuItem.ID),
+			Name:     menuItem.Name,
+			Price:    menuItem.Price,
+			Quantity: line.Quantity,
+			Total:    formatAmount(lineTotal),
+			Notes:    notes,
+		})
+	}
+
+	serviceChargePct, _ := strconv.ParseFloat(restaurant.ServiceCharge, 64)
+	gstPct, _ := strconv.ParseFloat(restaurant.GST, 64)
+	serviceCharge := subtotal * serviceChargePct / 100
+	gst := subtotal * gstPct / 100
+
+	s.nextOrderID++
+	order := models.Order{
+		ID:            s.nextOrderID,
+		RestaurantID:  input.RestaurantID,
+		OrderNumber:   "ORD-" + strconv.Itoa(int(s.nextOrderID)),
+		OrderType:     input.OrderType,
+		TableNumber:   input.TableNumber,
+		CustomerName:  input.CustomerName,
+		CustomerPhone: input.CustomerPhone,
+		Items:         items,
+		Subtotal:      formatAmount(subtotal),
+		ServiceCharge: formatAmount(serviceCharge),
+		GST:           formatAmount(gst),
+		Total:         formatAmount(subtotal + serviceCharge + gst),
+		Notes:         input.Notes,
+	}
+	s.orders[order.ID] = order
+
+	if input.IdempotencyKey != "" {
+		s.idempotencyKeys[idemKey] = order.ID
+	}
+
+	return &order, nil
+}
+
+func idempotencyMapKey(restaurantID uint, key string) string {
+	return strconv.FormatUint(uint64(restaurantID), 10) + ":" + key
+}
+
+func (s *memoryStore) GetOrder(ctx context.Context, restaurantID, id uint) (*models.Order, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, ok := s.orders[id]
+	if !ok || o.RestaurantID != restaurantID {
+		return nil, ErrNotFound
+	}
+	return &o, nil
+}
+
+func (s *memoryStore) UpdateOrderStatus(ctx context.Context, restaurantID, id uint, status string) (*models.Order, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, ok := s.orders[id]
+	if !ok || o.RestaurantID != restaurantID {
+		return nil, ErrNotFound
+	}
+	o.Status = status
+	o.UpdatedAt = time.Now()
+	s.orders[id] = o
+	return &o, nil
+}
+
+func (s *memoryStore) GetOrderByNumber(ctx context.Context, orderNumber string) (*models.Order, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, o := range s.orders {
+		if o.OrderNumber == orderNumber {
+			return &o, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// GetStats is a plain-Go approximation of postgresStore's SQL aggregation,
+// good enough for handler tests against data that's already in memory.
+func (s *memoryStore) GetStats(ctx context.Context, restaurantID uint, q StatsQuery) (StatsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	type bucketAcc struct {
+		orderCount   int
+		revenue      float64
+		prepSeconds  float64
+		prepSamples  int
+	}
+	buckets := make(map[time.Time]*bucketAcc)
+	itemTotals := make(map[string]*TopItem)
+	categoryRevenue := make(map[uint]*CategoryRevenue)
+	orderTypes := make(map[string]*OrderTypeShare)
+
+	for _, o := range s.orders {
+		if o.RestaurantID != restaurantID {
+			continue
+		}
+		if o.CreatedAt.Before(q.From) || o.CreatedAt.After(q.To) {
+			continue
+		}
+		total, _ := strconv.ParseFloat(o.Total, 64)
+
+		key := truncateToBucket(o.CreatedAt, q.Bucket)
+		acc, ok := buckets[key]
+		if !ok {
+			acc = &bucketAcc{}
+			buckets[key] = acc
+		}
+		acc.orderCount++
+		acc.revenue += total
+		if o.Status == "completed" {
+			acc.prepSeconds += o.UpdatedAt.Sub(o.CreatedAt).Seconds()
+			acc.prepSamples++
+		}
+
+		ot, ok := orderTypes[o.OrderType]
+		if !ok {
+			ot = &OrderTypeShare{OrderType: o.OrderType}
+			orderTypes[o.OrderType] = ot
+		}
+		ot.Count++
+		ot.Revenue += total
+
+		for _, item := range o.Items {
+			ti, ok := itemTotals[item.Name]
+			if !ok {
+				ti = &TopItem{Name: item.Name}
+				itemTotals[item.Name] = ti
+			}
+			ti.Quantity += item.Quantity
+			itemTotal, _ := strconv.ParseFloat(item.Total, 64)
+			ti.Revenue += itemTotal
+
+			if menuItem, ok := s.menuItems[uint(item.ID)]; ok {
+				if category, ok := s.categories[menuItem.CategoryID]; ok {
+					cr, ok := categoryRevenue[category.ID]
+					if !ok {
+						cr = &CategoryRevenue{CategoryID: category.ID, CategoryName: category.Name}
+						categoryRevenue[category.ID] = cr
+					}
+					cr.Revenue += itemTotal
+				}
+			}
+		}
+	}
+
+	resp := StatsResponse{}
+	for bucket, acc := range buckets {
+		tb := TimeBucket{Bucket: bucket, OrderCount: acc.orderCount, Revenue: acc.revenue}
+		if acc.prepSamples > 0 {
+			tb.AvgPrepTimeSeconds = acc.prepSeconds / float64(acc.prepSamples)
+		}
+		resp.Buckets = append(resp.Buckets, tb)
+	}
+	sort.Slice(resp.Buckets, func(i, j int) bool { return resp.Buckets[i].Bucket.Before(resp.Buckets[j].Bucket) })
+
+	for _, ti := range itemTotals {
+		resp.TopItemsByQuantity = append(resp.TopItemsByQuantity, *ti)
+		resp.TopItemsByRevenue = append(resp.TopItemsByRevenue, *ti)
+	}
+	sort.Slice(resp.TopItemsByQuantity, func(i, j int) bool {
+		return resp.TopItemsByQuantity[i].Quantity > resp.TopItemsByQuantity[j].Quantity
+	})
+	sort.Slice(resp.TopItemsByRevenue, func(i, j int) bool {
+		return resp.TopItemsByRevenue[i].Revenue > resp.TopItemsByRevenue[j].Revenue
+	})
+	if len(resp.TopItemsByQuantity) > topItemsLimit {
+		resp.TopItemsByQuantity = resp.TopItemsByQuantity[:topItemsLimit]
+	}
+	if len(resp.TopItemsByRevenue) > topItemsLimit {
+		resp.TopItemsByRevenue = resp.TopItemsByRevenue[:topItemsLimit]
+	}
+
+	for _, cr := range categoryRevenue {
+		resp.RevenueByCategory = append(resp.RevenueByCategory, *cr)
+	}
+	sort.Slice(resp.RevenueByCategory, func(i, j int) bool {
+		return resp.RevenueByCategory[i].Revenue > resp.RevenueByCategory[j].Revenue
+	})
+
+	for _, ot := range orderTypes {
+		resp.OrderTypeBreakdown = append(resp.OrderTypeBreakdown, *ot)
+	}
+
+	return resp, nil
+}
+
+func (s *memoryStore) CreatePayment(ctx context.Context, p *models.Payment) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextPaymentID++
+	p.ID = s.nextPaymentID
+	s.payments[p.ID] = *p
+	return nil
+}
+
+func (s *memoryStore) GetPaymentByGatewayOrderID(ctx context.Context, gatewayOrderID string) (*models.Payment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range s.payments {
+		if p.GatewayOrderID == gatewayOrderID {
+			return &p, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (s *memoryStore) UpdatePaymentStatus(ctx context.Context, id uint, status string, gatewayPaymentID *string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.payments[id]
+	if !ok {
+		return ErrNotFound
+	}
+	p.Status = status
+	if gatewayPaymentID != nil {
+		p.GatewayPaymentID = gatewayPaymentID
+	}
+	s.payments[id] = p
+	return nil
+}
+
+func truncateToBucket(t time.Time, bucket string) time.Time {
+	switch bucket {
+	case BucketHour:
+		return t.Truncate(time.Hour)
+	case BucketWeek:
+		weekday := int(t.Weekday())
+		if weekday == 0 {
+			weekday = 7
+		}
+		d := t.AddDate(0, 0, -(weekday - 1))
+		return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, d.Location())
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	}
+}
@@ -0,0 +1,94 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"qr-menu-app/internal/models"
+)
+
+func seededMemoryStore(t *testing.T) (Store, uint) {
+	t.Helper()
+	s := NewMemoryStore()
+	ctx := context.Background()
+
+	var restaurant models.Restaurant
+	restaurant.ServiceCharge = "10.00"
+	restaurant.GST = "5.00"
+	if err := s.CreateRestaurant(ctx, &restaurant); err != nil {
+		t.Fatalf("seed restaurant: %v", err)
+	}
+
+	item := models.MenuItem{RestaurantID: restaurant.ID, Name: "Biryani", Price: "500.00", IsAvailable: true}
+	if err := s.CreateMenuItem(ctx, &item); err != nil {
+		t.Fatalf("seed menu item: %v", err)
+	}
+
+	return s, restaurant.ID
+}
+
+func TestCreateOrderComputesTotalsServerSide(t *testing.T) {
+	s, restaurantID := seededMemoryStore(t)
+	ctx := context.Background()
+
+	order, err := s.CreateOrder(ctx, CreateOrderInput{
+		RestaurantID: restaurantID,
+		OrderType:    "dine-in",
+		Items:        []OrderLineRequest{{MenuItemID: 1, Quantity: 2}},
+	})
+	if err != nil {
+		t.Fatalf("CreateOrder: %v", err)
+	}
+
+	// 2 x 500.00 = 1000.00 subtotal, +10% service charge, +5% GST
+	if order.Subtotal != "1000.00" {
+		t.Errorf("Subtotal = %q, want 1000.00", order.Subtotal)
+	}
+	if order.Total != "1150.00" {
+		t.Errorf("Total = %q, want 1150.00 (client-sent price would have been ignored anyway)", order.Total)
+	}
+}
+
+func TestCreateOrderRejectsUnavailableItem(t *testing.T) {
+	s, restaurantID := seededMemoryStore(t)
+	ctx := context.Background()
+
+	if _, err := s.UpdateMenuItem(ctx, restaurantID, 1, map[string]interface{}{"isAvailable": false}); err != nil {
+		t.Fatalf("mark item unavailable: %v", err)
+	}
+
+	_, err := s.CreateOrder(ctx, CreateOrderInput{
+		RestaurantID: restaurantID,
+		OrderType:    "dine-in",
+		Items:        []OrderLineRequest{{MenuItemID: 1, Quantity: 1}},
+	})
+	if err != ErrItemUnavailable {
+		t.Errorf("err = %v, want ErrItemUnavailable", err)
+	}
+}
+
+func TestCreateOrderIsIdempotent(t *testing.T) {
+	s, restaurantID := seededMemoryStore(t)
+	ctx := context.Background()
+
+	input := CreateOrderInput{
+		RestaurantID:   restaurantID,
+		OrderType:      "dine-in",
+		Items:          []OrderLineRequest{{MenuItemID: 1, Quantity: 1}},
+		IdempotencyKey: "retry-123",
+	}
+
+	first, err := s.CreateOrder(ctx, input)
+	if err != nil {
+		t.Fatalf("first CreateOrder: %v", err)
+	}
+
+	second, err := s.CreateOrder(ctx, input)
+	if err != nil {
+		t.Fatalf("retried CreateOrder: %v", err)
+	}
+
+	if first.ID != second.ID {
+		t.Errorf("retried request created a second order: first.ID=%d second.ID=%d", first.ID, second.ID)
+	}
+}
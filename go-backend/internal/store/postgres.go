@@ -0,0 +1,476 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"qr-menu-app/internal/models"
+)
+
+// postgresStore is the production Store, backed by GORM over Postgres.
+type postgresStore struct {
+	db *gorm.DB
+}
+
+// NewPostgresStore opens databaseURL and runs AutoMigrate for every model the
+// API package knows about.
+func NewPostgresStore(databaseURL string) (Store, error) {
+	db, err := gorm.Open(postgres.Open(databaseURL), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("connect to database: %w", err)
+	}
+
+	if err := db.AutoMigrate(
+		&models.Restaurant{},
+		&models.MenuCategory{},
+		&models.MenuItem{},
+		&models.Order{},
+		&models.User{},
+		&models.OrderIdempotencyKey{},
+		&models.Payment{},
+	); err != nil {
+		return nil, fmt.Errorf("migrate database: %w", err)
+	}
+
+	return &postgresStore{db: db}, nil
+}
+
+func wrapNotFound(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
+
+func (s *postgresStore) ListRestaurants(ctx context.Context) ([]models.Restaurant, error) {
+	var restaurants []models.Restaurant
+	err := s.db.WithContext(ctx).Find(&restaurants).Error
+	return restaurants, err
+}
+
+func (s *postgresStore) CreateRestaurant(ctx context.Context, r *models.Restaurant) error {
+	return s.db.WithContext(ctx).Create(r).Error
+}
+
+func (s *postgresStore) GetRestaurant(ctx context.Context, id uint) (*models.Restaurant, error) {
+	var r models.Restaurant
+	if err := s.db.WithContext(ctx).First(&r, id).Error; err != nil {
+		return nil, wrapNotFound(err)
+	}
+	return &r, nil
+}
+
+// restaurantEditableColumns whitelists the columns an owner/manager may
+// change through the restaurant settings endpoint. Keys are snake_case DB
+// column names, not the JSON field names - GORM's Updates(map) uses map
+// keys verbatim as column names, it does not run them through the naming
+// strategy. Billing columns (plan_type, subscription_end_date,
+// monthly_rate, is_active) are deliberately excluded - plan_type,
+// subscription_end_date and is_active are only written by
+// ActivateSubscription and SetRestaurantActive, after a verified payment
+// confirmation or Razorpay webhook.
+var restaurantEditableColumns = map[string]bool{
+	"name":            true,
+	"description":     true,
+	"address":         true,
+	"phone":           true,
+	"email":           true,
+	"logo":            true,
+	"primary_color":   true,
+	"secondary_color": true,
+	"accent_color":    true,
+	"table_count":     true,
+	"service_charge":  true,
+	"gst":             true,
+	"order_modes":     true,
+}
+
+func (s *postgresStore) UpdateRestaurant(ctx context.Context, id uint, updates map[string]interface{}) (*models.Restaurant, error) {
+	r, err := s.GetRestaurant(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make(map[string]interface{}, len(updates))
+	for k, v := range updates {
+		if restaurantEditableColumns[k] {
+			filtered[k] = v
+		}
+	}
+	if err := s.db.WithContext(ctx).Model(r).Updates(filtered).Error; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ActivateSubscription writes the billing columns directly, bypassing
+// restaurantEditableColumns entirely - these are never accepted from an
+// owner's settings PATCH, only from a verified payment confirmation or
+// Razorpay webhook.
+func (s *postgresStore) ActivateSubscription(ctx context.Context, id uint, planType string, endDate time.Time) (*models.Restaurant, error) {
+	r, err := s.GetRestaurant(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	updates := map[string]interface{}{
+		"plan_type":             planType,
+		"subscription_end_date": endDate,
+		"is_active":             true,
+	}
+	if err := s.db.WithContext(ctx).Model(r).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// SetRestaurantActive flips is_active on its own, for the Razorpay webhook's
+// subscription.halted path.
+func (s *postgresStore) SetRestaurantActive(ctx context.Context, id uint, active bool) error {
+	return s.db.WithContext(ctx).Model(&models.Restaurant{}).
+		Where("id = ?", id).
+		Update("is_active", active).Error
+}
+
+func (s *postgresStore) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	var u models.User
+	if err := s.db.WithContext(ctx).Where("email = ?", email).First(&u).Error; err != nil {
+		return nil, wrapNotFound(err)
+	}
+	return &u, nil
+}
+
+func (s *postgresStore) GetUserByID(ctx context.Context, id uint) (*models.User, error) {
+	var u models.User
+	if err := s.db.WithContext(ctx).First(&u, id).Error; err != nil {
+		return nil, wrapNotFound(err)
+	}
+	return &u, nil
+}
+
+func (s *postgresStore) ListMenuCategories(ctx context.Context, restaurantID uint) ([]models.MenuCategory, error) {
+	var categories []models.MenuCategory
+	err := s.db.WithContext(ctx).Where("restaurant_id = ?", restaurantID).Order("display_order").Find(&categories).Error
+	return categories, err
+}
+
+func (s *postgresStore) ListMenuItems(ctx context.Context, restaurantID uint, categoryID uint) ([]models.MenuItem, error) {
+	var items []models.MenuItem
+	query := s.db.WithContext(ctx).Where("restaurant_id = ?", restaurantID)
+	if categoryID != 0 {
+		query = query.Where("category_id = ?", categoryID)
+	}
+	err := query.Order("display_order").Find(&items).Error
+	return items, err
+}
+
+func (s *postgresStore) CreateMenuItem(ctx context.Context, item *models.MenuItem) error {
+	return s.db.WithContext(ctx).Create(item).Error
+}
+
+func (s *postgresStore) UpdateMenuItem(ctx context.Context, restaurantID, id uint, updates map[string]interface{}) (*models.MenuItem, error) {
+	var item models.MenuItem
+	if err := s.db.WithContext(ctx).Where("restaurant_id = ?", restaurantID).First(&item, id).Error; err != nil {
+		return nil, wrapNotFound(err)
+	}
+	delete(updates, "restaurantId")
+	if err := s.db.WithContext(ctx).Model(&item).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (s *postgresStore) SetMenuItemAvailability(ctx context.Context, restaurantID, id uint, available bool) error {
+	return s.db.WithContext(ctx).Model(&models.MenuItem{}).
+		Where("restaurant_id = ? AND id = ?", restaurantID, id).
+		Update("is_available", available).Error
+}
+
+func (s *postgresStore) ListOrders(ctx context.Context, restaurantID uint, status string) ([]models.Order, error) {
+	var orders []models.Order
+	query := s.db.WithContext(ctx).Where("restaurant_id = ?", restaurantID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Order("created_at DESC").Find(&orders).Error
+	return orders, err
+}
+
+// CreateOrder recomputes every amount from the database instead of trusting
+// the client, checks each item is still available, and - if the caller sent
+// an IdempotencyKey - makes a retried request return the original order
+// instead of creating a second one. Everything happens in one transaction so
+// a half-written order never becomes visible.
+func (s *postgresStore) CreateOrder(ctx context.Context, input CreateOrderInput) (*models.Order, error) {
+	if len(input.Items) == 0 {
+		return nil, ErrOrderEmpty
+	}
+
+	if input.IdempotencyKey != "" {
+		if existing, err := s.orderForIdempotencyKey(ctx, input.RestaurantID, input.IdempotencyKey); err == nil {
+			return existing, nil
+		} else if !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+	}
+
+	var order models.Order
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var restaurant models.Restaurant
+		if err := tx.First(&restaurant, input.RestaurantID).Error; err != nil {
+			return wrapNotFound(err)
+		}
+
+		items, subtotal, err := resolveOrderItems(tx, input.RestaurantID, input.Items)
+		if err != nil {
+			return err
+		}
+
+		serviceChargePct, _ := strconv.ParseFloat(restaurant.ServiceCharge, 64)
+		gstPct, _ := strconv.ParseFloat(restaurant.GST, 64)
+		serviceCharge := subtotal * serviceChargePct / 100
+		gst := subtotal * gstPct / 100
+
+		order = models.Order{
+			RestaurantID:  input.RestaurantID,
+			OrderNumber:   fmt.Sprintf("ORD-%d-%d", time.Now().Unix(), input.RestaurantID),
+			OrderType:     input.OrderType,
+			TableNumber:   input.TableNumber,
+			CustomerName:  input.CustomerName,
+			CustomerPhone: input.CustomerPhone,
+			Items:         items,
+			Subtotal:      formatAmount(subtotal),
+			ServiceCharge: formatAmount(serviceCharge),
+			GST:           formatAmount(gst),
+			Total:         formatAmount(subtotal + serviceCharge + gst),
+			Notes:         input.Notes,
+		}
+		if err := tx.Create(&order).Error; err != nil {
+			return err
+		}
+
+		if input.IdempotencyKey != "" {
+			idem := models.OrderIdempotencyKey{
+				RestaurantID: input.RestaurantID,
+				Key:          input.IdempotencyKey,
+				OrderID:      order.ID,
+			}
+			if err := tx.Create(&idem).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		// Lost a race with another request carrying the same idempotency
+		// key: the other one committed first, so return its order instead
+		// of surfacing a spurious unique-constraint error.
+		if input.IdempotencyKey != "" && isUniqueViolation(err) {
+			if existing, ferr := s.orderForIdempotencyKey(ctx, input.RestaurantID, input.IdempotencyKey); ferr == nil {
+				return existing, nil
+			}
+		}
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+func (s *postgresStore) orderForIdempotencyKey(ctx context.Context, restaurantID uint, key string) (*models.Order, error) {
+	var idem models.OrderIdempotencyKey
+	if err := s.db.WithContext(ctx).
+		Where("restaurant_id = ? AND key = ?", restaurantID, key).
+		First(&idem).Error; err != nil {
+		return nil, wrapNotFound(err)
+	}
+	return s.GetOrder(ctx, restaurantID, idem.OrderID)
+}
+
+// resolveOrderItems loads each requested menu item inside tx, rejects
+// unavailable ones, and recomputes line totals from the DB price. It
+// returns the models.OrderItem rows ready to persist and their subtotal.
+func resolveOrderItems(tx *gorm.DB, restaurantID uint, lines []OrderLineRequest) ([]models.OrderItem, float64, error) {
+	items := make([]models.OrderItem, 0, len(lines))
+	var subtotal float64
+
+	for _, line := range lines {
+		if line.Quantity <= 0 {
+			return nil, 0, ErrInvalidQuantity
+		}
+
+		var menuItem models.MenuItem
+		if err := tx.Where("restaurant_id = ?", restaurantID).First(&menuItem, line.MenuItemID).Error; err != nil {
+			return nil, 0, fmt.Errorf("menu item %d: %w", line.MenuItemID, wrapNotFound(err))
+		}
+		if !menuItem.IsAvailable {
+			return nil, 0, fmt.Errorf("%w: %s", ErrItemUnavailable, menuItem.Name)
+		}
+
+		price, err := strconv.ParseFloat(menuItem.Price, 64)
+		if err != nil {
+			return nil, 0, fmt.Errorf("menu item %d has an invalid price: %w", menuItem.ID, err)
+		}
+		lineTotal := price * float64(line.Quantity)
+		subtotal += lineTotal
+
+		var notes *string
+		if noteText := line.Notes; noteText != "" {
+			notes = &noteText
+		}
+
+		items = append(items, models.OrderItem{
+			ID:       int(menuItem.ID),
+			Name:     menuItem.Name,
+			Price:    menuItem.Price,
+			Quantity: line.Quantity,
+			Total:    formatAmount(lineTotal),
+			Notes:    notes,
+		})
+	}
+
+	return items, subtotal, nil
+}
+
+func formatAmount(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+func isUniqueViolation(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "duplicate key")
+}
+
+func (s *postgresStore) GetOrder(ctx context.Context, restaurantID, id uint) (*models.Order, error) {
+	var o models.Order
+	if err := s.db.WithContext(ctx).Where("restaurant_id = ?", restaurantID).First(&o, id).Error; err != nil {
+		return nil, wrapNotFound(err)
+	}
+	return &o, nil
+}
+
+func (s *postgresStore) UpdateOrderStatus(ctx context.Context, restaurantID, id uint, status string) (*models.Order, error) {
+	o, err := s.GetOrder(ctx, restaurantID, id)
+	if err != nil {
+		return nil, err
+	}
+	o.Status = status
+	o.UpdatedAt = time.Now()
+	if err := s.db.WithContext(ctx).Save(o).Error; err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+func (s *postgresStore) GetOrderByNumber(ctx context.Context, orderNumber string) (*models.Order, error) {
+	var o models.Order
+	if err := s.db.WithContext(ctx).Where("order_number = ?", orderNumber).First(&o).Error; err != nil {
+		return nil, wrapNotFound(err)
+	}
+	return &o, nil
+}
+
+func (s *postgresStore) CreatePayment(ctx context.Context, p *models.Payment) error {
+	return s.db.WithContext(ctx).Create(p).Error
+}
+
+func (s *postgresStore) GetPaymentByGatewayOrderID(ctx context.Context, gatewayOrderID string) (*models.Payment, error) {
+	var p models.Payment
+	if err := s.db.WithContext(ctx).Where("gateway_order_id = ?", gatewayOrderID).First(&p).Error; err != nil {
+		return nil, wrapNotFound(err)
+	}
+	return &p, nil
+}
+
+func (s *postgresStore) UpdatePaymentStatus(ctx context.Context, id uint, status string, gatewayPaymentID *string) error {
+	updates := map[string]interface{}{"status": status}
+	if gatewayPaymentID != nil {
+		updates["gateway_payment_id"] = *gatewayPaymentID
+	}
+	return s.db.WithContext(ctx).Model(&models.Payment{}).Where("id = ?", id).Updates(updates).Error
+}
+
+const topItemsLimit = 10
+
+// GetStats computes the restaurant's reporting dashboard entirely in SQL:
+// revenue/order-count/avg-prep-time bucketed by q.Bucket, top items by
+// quantity and by revenue (unnested from the Order.Items jsonb column),
+// revenue attributed back to menu categories, and the dine-in/takeaway
+// split. Loading every order into Go and summing in a loop, as the old
+// getStats did, doesn't scale past a few hundred orders a day.
+func (s *postgresStore) GetStats(ctx context.Context, restaurantID uint, q StatsQuery) (StatsResponse, error) {
+	db := s.db.WithContext(ctx)
+	var resp StatsResponse
+
+	if err := db.Raw(`
+		SELECT
+			date_trunc(?, created_at)                                                         AS bucket,
+			COUNT(*)                                                                           AS order_count,
+			SUM(total::numeric)                                                                AS revenue,
+			COALESCE(AVG(EXTRACT(EPOCH FROM (updated_at - created_at)))
+				FILTER (WHERE status = 'completed'), 0)                                        AS avg_prep_time_seconds
+		FROM orders
+		WHERE restaurant_id = ? AND created_at BETWEEN ? AND ?
+		GROUP BY bucket
+		ORDER BY bucket
+	`, q.Bucket, restaurantID, q.From, q.To).Scan(&resp.Buckets).Error; err != nil {
+		return StatsResponse{}, fmt.Errorf("bucket stats: %w", err)
+	}
+
+	if err := db.Raw(`
+		SELECT item->>'name' AS name,
+			SUM((item->>'quantity')::int)     AS quantity,
+			SUM((item->>'total')::numeric)    AS revenue
+		FROM orders o, jsonb_array_elements(o.items) AS item
+		WHERE o.restaurant_id = ? AND o.created_at BETWEEN ? AND ?
+		GROUP BY name
+		ORDER BY quantity DESC
+		LIMIT ?
+	`, restaurantID, q.From, q.To, topItemsLimit).Scan(&resp.TopItemsByQuantity).Error; err != nil {
+		return StatsResponse{}, fmt.Errorf("top items by quantity: %w", err)
+	}
+
+	if err := db.Raw(`
+		SELECT item->>'name' AS name,
+			SUM((item->>'quantity')::int)     AS quantity,
+			SUM((item->>'total')::numeric)    AS revenue
+		FROM orders o, jsonb_array_elements(o.items) AS item
+		WHERE o.restaurant_id = ? AND o.created_at BETWEEN ? AND ?
+		GROUP BY name
+		ORDER BY revenue DESC
+		LIMIT ?
+	`, restaurantID, q.From, q.To, topItemsLimit).Scan(&resp.TopItemsByRevenue).Error; err != nil {
+		return StatsResponse{}, fmt.Errorf("top items by revenue: %w", err)
+	}
+
+	if err := db.Raw(`
+		SELECT mc.id   AS category_id,
+			mc.name AS category_name,
+			SUM((item->>'total')::numeric) AS revenue
+		FROM orders o, jsonb_array_elements(o.items) AS item
+		JOIN menu_items mi ON mi.id = (item->>'id')::int
+		JOIN menu_categories mc ON mc.id = mi.category_id
+		WHERE o.restaurant_id = ? AND o.created_at BETWEEN ? AND ?
+		GROUP BY mc.id, mc.name
+		ORDER BY revenue DESC
+	`, restaurantID, q.From, q.To).Scan(&resp.RevenueByCategory).Error; err != nil {
+		return StatsResponse{}, fmt.Errorf("revenue by category: %w", err)
+	}
+
+	if err := db.Raw(`
+		SELECT order_type,
+			COUNT(*)             AS count,
+			SUM(total::numeric)  AS revenue
+		FROM orders
+		WHERE restaurant_id = ? AND created_at BETWEEN ? AND ?
+		GROUP BY order_type
+	`, restaurantID, q.From, q.To).Scan(&resp.OrderTypeBreakdown).Error; err != nil {
+		return StatsResponse{}, fmt.Errorf("order type breakdown: %w", err)
+	}
+
+	return resp, nil
+}
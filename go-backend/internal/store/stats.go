@@ -0,0 +1,55 @@
+package store
+
+import "time"
+
+// Bucket width for StatsQuery.Bucket, matching Postgres's date_trunc units.
+const (
+	BucketHour = "hour"
+	BucketDay  = "day"
+	BucketWeek = "week"
+)
+
+// StatsQuery is the time window and granularity for GetStats.
+type StatsQuery struct {
+	From   time.Time
+	To     time.Time
+	Bucket string
+}
+
+// TimeBucket is one date_trunc'd row of the revenue/order timeline.
+type TimeBucket struct {
+	Bucket             time.Time `json:"bucket"`
+	OrderCount         int       `json:"orderCount"`
+	Revenue            float64   `json:"revenue"`
+	AvgPrepTimeSeconds float64   `json:"avgPrepTimeSeconds"`
+}
+
+// TopItem is one row of the top-N-by-quantity / top-N-by-revenue item list.
+type TopItem struct {
+	Name     string  `json:"name"`
+	Quantity int     `json:"quantity"`
+	Revenue  float64 `json:"revenue"`
+}
+
+// CategoryRevenue is revenue attributed back to a menu category.
+type CategoryRevenue struct {
+	CategoryID   uint    `json:"categoryId"`
+	CategoryName string  `json:"categoryName"`
+	Revenue      float64 `json:"revenue"`
+}
+
+// OrderTypeShare is order count/revenue split by dine-in vs takeaway.
+type OrderTypeShare struct {
+	OrderType string  `json:"orderType"`
+	Count     int     `json:"count"`
+	Revenue   float64 `json:"revenue"`
+}
+
+// StatsResponse is the full payload for GET /api/restaurant/{id}/stats.
+type StatsResponse struct {
+	Buckets            []TimeBucket      `json:"buckets"`
+	TopItemsByQuantity []TopItem         `json:"topItemsByQuantity"`
+	TopItemsByRevenue  []TopItem         `json:"topItemsByRevenue"`
+	RevenueByCategory  []CategoryRevenue `json:"revenueByCategory"`
+	OrderTypeBreakdown []OrderTypeShare  `json:"orderTypeBreakdown"`
+}
@@ -0,0 +1,76 @@
+// Package store defines the persistence interface used by internal/api, so
+// handlers can be unit-tested against memoryStore without a live Postgres.
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"qr-menu-app/internal/models"
+)
+
+// ErrNotFound is returned by any lookup method when the row doesn't exist
+// (or, for tenant-scoped lookups, doesn't belong to the given restaurant).
+var ErrNotFound = errors.New("store: not found")
+
+// Errors CreateOrder can return once it starts validating line items against
+// the menu instead of trusting whatever the client sent.
+var (
+	ErrOrderEmpty       = errors.New("store: order has no items")
+	ErrItemUnavailable  = errors.New("store: menu item is not available")
+	ErrInvalidQuantity  = errors.New("store: item quantity must be positive")
+)
+
+// OrderLineRequest is one line of a create-order request: just enough for
+// the store to look up the authoritative menu item and price - the client
+// never gets to say what something costs.
+type OrderLineRequest struct {
+	MenuItemID uint
+	Quantity   int
+	Notes      string
+}
+
+// CreateOrderInput is everything a customer-facing create-order request is
+// allowed to specify. Amounts are computed server-side in CreateOrder.
+type CreateOrderInput struct {
+	RestaurantID   uint
+	OrderType      string
+	TableNumber    *string
+	CustomerName   *string
+	CustomerPhone  *string
+	Notes          *string
+	Items          []OrderLineRequest
+	IdempotencyKey string
+}
+
+type Store interface {
+	ListRestaurants(ctx context.Context) ([]models.Restaurant, error)
+	CreateRestaurant(ctx context.Context, r *models.Restaurant) error
+	GetRestaurant(ctx context.Context, id uint) (*models.Restaurant, error)
+	UpdateRestaurant(ctx context.Context, id uint, updates map[string]interface{}) (*models.Restaurant, error)
+	ActivateSubscription(ctx context.Context, id uint, planType string, endDate time.Time) (*models.Restaurant, error)
+	SetRestaurantActive(ctx context.Context, id uint, active bool) error
+
+	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
+	GetUserByID(ctx context.Context, id uint) (*models.User, error)
+
+	ListMenuCategories(ctx context.Context, restaurantID uint) ([]models.MenuCategory, error)
+
+	ListMenuItems(ctx context.Context, restaurantID uint, categoryID uint) ([]models.MenuItem, error)
+	CreateMenuItem(ctx context.Context, item *models.MenuItem) error
+	UpdateMenuItem(ctx context.Context, restaurantID, id uint, updates map[string]interface{}) (*models.MenuItem, error)
+	SetMenuItemAvailability(ctx context.Context, restaurantID, id uint, available bool) error
+
+	ListOrders(ctx context.Context, restaurantID uint, status string) ([]models.Order, error)
+	CreateOrder(ctx context.Context, input CreateOrderInput) (*models.Order, error)
+	GetOrder(ctx context.Context, restaurantID, id uint) (*models.Order, error)
+	UpdateOrderStatus(ctx context.Context, restaurantID, id uint, status string) (*models.Order, error)
+	GetOrderByNumber(ctx context.Context, orderNumber string) (*models.Order, error)
+
+	GetStats(ctx context.Context, restaurantID uint, q StatsQuery) (StatsResponse, error)
+
+	CreatePayment(ctx context.Context, p *models.Payment) error
+	GetPaymentByGatewayOrderID(ctx context.Context, gatewayOrderID string) (*models.Payment, error)
+	UpdatePaymentStatus(ctx context.Context, id uint, status string, gatewayPaymentID *string) error
+}
@@ -0,0 +1,188 @@
+// Package ws holds the per-restaurant WebSocket broadcast hub used to push
+// order and menu updates to connected clients in real time. Each restaurant
+// gets its own room so a slow or misbehaving client in one tenant can't
+// affect another, and so a broadcast never crosses tenant boundaries.
+package ws
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"qr-menu-app/internal/observability"
+)
+
+const (
+	// writeWait is how long a single WriteMessage call (including pings) is
+	// allowed to take before the connection is considered dead.
+	writeWait = 10 * time.Second
+	// pongWait is how long we wait for a pong before giving up on a client.
+	pongWait = 60 * time.Second
+	// pingPeriod must be less than pongWait; gorilla's chat example uses 9/10.
+	pingPeriod = (pongWait * 9) / 10
+	// sendBufferSize bounds how far a client can fall behind before it's
+	// dropped instead of blocking the room.
+	sendBufferSize = 256
+)
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// Event is the typed envelope broadcast to clients. RestaurantID determines
+// which room receives it; a client only ever joins one room so it doesn't
+// need the field, but it is marshaled into every message anyway, which costs
+// nothing and makes the envelope self-describing in logs.
+type Event struct {
+	Type         string      `json:"type"`
+	RestaurantID uint        `json:"restaurantId"`
+	Payload      interface{} `json:"payload"`
+}
+
+// Client is one connected WebSocket, pinned to a single restaurant's room.
+type Client struct {
+	hub          *Hub
+	conn         *websocket.Conn
+	send         chan []byte
+	restaurantID uint
+}
+
+// Hub fans events out to the clients in the event's restaurant room.
+type Hub struct {
+	mu    sync.RWMutex
+	rooms map[uint]map[*Client]bool
+}
+
+// NewHub returns an empty Hub. Unlike the old single-goroutine broadcast
+// loop, there's no background loop to start - each client pumps its own
+// messages, so Broadcast can be called directly from request handlers.
+func NewHub() *Hub {
+	return &Hub{rooms: make(map[uint]map[*Client]bool)}
+}
+
+// ServeWS upgrades the request to a WebSocket and joins it to restaurantID's
+// room. Callers resolve restaurantID (from the JWT or a query param) before
+// calling this - the hub itself has no notion of auth.
+func (h *Hub) ServeWS(w http.ResponseWriter, r *http.Request, restaurantID uint) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Printf("WebSocket upgrade error: %v", err)
+		return
+	}
+
+	client := &Client{
+		hub:          h,
+		conn:         conn,
+		send:         make(chan []byte, sendBufferSize),
+		restaurantID: restaurantID,
+	}
+	h.register(client)
+
+	go client.writePump()
+	go client.readPump()
+}
+
+func (h *Hub) register(c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.rooms[c.restaurantID] == nil {
+		h.rooms[c.restaurantID] = make(map[*Client]bool)
+	}
+	h.rooms[c.restaurantID][c] = true
+	observability.RecordWebSocketConnect(c.restaurantID)
+}
+
+func (h *Hub) unregister(c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	room, ok := h.rooms[c.restaurantID]
+	if !ok {
+		return
+	}
+	if _, ok := room[c]; !ok {
+		return
+	}
+	delete(room, c)
+	close(c.send)
+	if len(room) == 0 {
+		delete(h.rooms, c.restaurantID)
+	}
+	observability.RecordWebSocketDisconnect(c.restaurantID)
+}
+
+// readPump's only job is keeping the read deadline alive via pong handling
+// and noticing when the client goes away; the API never sends inbound
+// messages over this socket.
+func (c *Client) readPump() {
+	defer func() {
+		c.hub.unregister(c)
+		c.conn.Close()
+	}()
+
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		c.conn.SetReadDeadline(time.Now().Add(pongWait))
+		return nil
+	})
+
+	for {
+		if _, _, err := c.conn.ReadMessage(); err != nil {
+			break
+		}
+	}
+}
+
+func (c *Client) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case message, ok := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+				return
+			}
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Broadcast routes event to every client in event.RestaurantID's room. Sends
+// are non-blocking: a client whose send buffer is full is dropped instead of
+// stalling the broadcast for everyone else.
+func (h *Hub) Broadcast(event Event) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("Error marshaling broadcast event: %v", err)
+		return
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for c := range h.rooms[event.RestaurantID] {
+		select {
+		case c.send <- data:
+		default:
+			go h.unregister(c)
+		}
+	}
+}